@@ -0,0 +1,154 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// DecodeOption configures DecodeJSON.
+type DecodeOption func(*decodeConfig)
+
+type decodeConfig struct {
+	useNumber bool
+}
+
+// WithNumber makes DecodeJSON decode JSON numbers as json.Number instead of
+// float64, mirroring json.Decoder.UseNumber.
+func WithNumber() DecodeOption {
+	return func(c *decodeConfig) {
+		c.useNumber = true
+	}
+}
+
+// DecodeJSON reads a full JSON document from r into *v (v must be a *any),
+// preserving key order at every nesting level: every JSON object becomes an
+// *OrderedMap[string, any] rather than the unordered map[string]interface{}
+// that encoding/json would produce, and JSON arrays become []any whose
+// elements go through the same treatment.
+func DecodeJSON(r io.Reader, v any, opts ...DecodeOption) error {
+	ptr, ok := v.(*any)
+	if !ok {
+		return fmt.Errorf("orderedmap: DecodeJSON requires a *any destination, got %T", v)
+	}
+
+	var cfg decodeConfig
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	dec := json.NewDecoder(r)
+	if cfg.useNumber {
+		dec.UseNumber()
+	}
+
+	value, err := decodeValue(dec)
+	if err != nil {
+		return err
+	}
+
+	if _, err := dec.Token(); err != io.EOF {
+		if err == nil {
+			return fmt.Errorf("orderedmap: unexpected trailing data after JSON value")
+		}
+		return err
+	}
+
+	*ptr = value
+	return nil
+}
+
+func decodeValue(dec *json.Decoder) (any, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok {
+		return token, nil
+	}
+
+	switch delim {
+	case '{':
+		return decodeObject(dec)
+	case '[':
+		return decodeArray(dec)
+	default:
+		return nil, fmt.Errorf("orderedmap: unexpected delimiter %q", delim)
+	}
+}
+
+func decodeObject(dec *json.Decoder) (*OrderedMap[string, any], error) {
+	om := New[string, any]()
+
+	for dec.More() {
+		keyToken, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		key, ok := keyToken.(string)
+		if !ok {
+			return nil, fmt.Errorf("orderedmap: expected a string object key, got %v", keyToken)
+		}
+
+		value, err := decodeValue(dec)
+		if err != nil {
+			return nil, err
+		}
+
+		om.Set(key, value)
+	}
+
+	// consume the closing '}'
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	return om, nil
+}
+
+func decodeArray(dec *json.Decoder) ([]any, error) {
+	arr := []any{}
+
+	for dec.More() {
+		value, err := decodeValue(dec)
+		if err != nil {
+			return nil, err
+		}
+
+		arr = append(arr, value)
+	}
+
+	// consume the closing ']'
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+
+	return arr, nil
+}
+
+// AnyOrdered is a json.RawMessage-style type: use it in place of any/
+// interface{} in a struct field (or as a standalone Unmarshal target) to get
+// order-preserving decoding of nested JSON objects, the same as DecodeJSON
+// would produce.
+type AnyOrdered struct {
+	Value any
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (a *AnyOrdered) UnmarshalJSON(data []byte) error {
+	var v any
+	if err := DecodeJSON(bytes.NewReader(data), &v); err != nil {
+		return err
+	}
+	a.Value = v
+	return nil
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (a AnyOrdered) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Value)
+}
@@ -0,0 +1,102 @@
+package orderedmap
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	t.Run("nested objects keep their order", func(t *testing.T) {
+		data := `{"b":1,"a":{"z":1,"y":{"n":1,"m":2}},"c":[{"q":1,"p":2},1,"foo"]}`
+
+		var v any
+		require.NoError(t, DecodeJSON(strings.NewReader(data), &v))
+
+		top, ok := v.(*OrderedMap[string, any])
+		require.True(t, ok)
+		assertOrderedPairsEqual(t, top, []string{"b", "a", "c"}, []any{
+			float64(1),
+			mustGet(t, top, "a"),
+			mustGet(t, top, "c"),
+		})
+
+		nested, ok := mustGet(t, top, "a").(*OrderedMap[string, any])
+		require.True(t, ok)
+		assert.Equal(t, []string{"z", "y"}, nested.Keys())
+
+		deepest, ok := mustGet(t, nested, "y").(*OrderedMap[string, any])
+		require.True(t, ok)
+		assert.Equal(t, []string{"n", "m"}, deepest.Keys())
+
+		arr, ok := mustGet(t, top, "c").([]any)
+		require.True(t, ok)
+		require.Len(t, arr, 3)
+		inArr, ok := arr[0].(*OrderedMap[string, any])
+		require.True(t, ok)
+		assert.Equal(t, []string{"q", "p"}, inArr.Keys())
+		assert.Equal(t, float64(1), arr[1])
+		assert.Equal(t, "foo", arr[2])
+	})
+
+	t.Run("scalars", func(t *testing.T) {
+		for data, expected := range map[string]any{
+			"42":    float64(42),
+			`"foo"`: "foo",
+			"true":  true,
+			"null":  nil,
+		} {
+			var v any
+			require.NoError(t, DecodeJSON(strings.NewReader(data), &v))
+			assert.Equal(t, expected, v)
+		}
+	})
+
+	t.Run("with WithNumber", func(t *testing.T) {
+		data := `{"n":28}`
+
+		var v any
+		require.NoError(t, DecodeJSON(strings.NewReader(data), &v, WithNumber()))
+
+		om, ok := v.(*OrderedMap[string, any])
+		require.True(t, ok)
+		value, present := om.Get("n")
+		require.True(t, present)
+		assert.Equal(t, json.Number("28"), value)
+	})
+
+	t.Run("trailing data is rejected", func(t *testing.T) {
+		var v any
+		require.Error(t, DecodeJSON(strings.NewReader(`{"a":1} {"b":2}`), &v))
+	})
+
+	t.Run("destination must be a *any", func(t *testing.T) {
+		var om OrderedMap[string, any]
+		require.Error(t, DecodeJSON(strings.NewReader(`{}`), &om))
+	})
+}
+
+func TestAnyOrdered(t *testing.T) {
+	t.Run("preserves order of nested objects", func(t *testing.T) {
+		var a AnyOrdered
+		require.NoError(t, json.Unmarshal([]byte(`{"b":1,"a":{"y":1,"x":2}}`), &a))
+
+		om, ok := a.Value.(*OrderedMap[string, any])
+		require.True(t, ok)
+		assert.Equal(t, []string{"b", "a"}, om.Keys())
+
+		nested, ok := mustGet(t, om, "a").(*OrderedMap[string, any])
+		require.True(t, ok)
+		assert.Equal(t, []string{"y", "x"}, nested.Keys())
+	})
+
+	t.Run("marshals its Value through MarshalJSON", func(t *testing.T) {
+		a := AnyOrdered{Value: "foo"}
+		b, err := json.Marshal(a)
+		assert.NoError(t, err)
+		assert.Equal(t, `"foo"`, string(b))
+	})
+}
@@ -0,0 +1,228 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MarshalJSONOptions configures MarshalJSONWithOptions. The zero value
+// produces standard, valid JSON: HTML is escaped and numeric keys are
+// quoted, matching json.Marshal.
+type MarshalJSONOptions struct {
+	// Prefix begins every line after the first, like json.MarshalIndent's
+	// prefix.
+	Prefix string
+	// Indent is repeated once per nesting level, like json.MarshalIndent's
+	// indent. Leaving it empty produces compact output, same as MarshalJSON.
+	Indent string
+
+	// DisableHTMLEscape turns off the escaping of '<', '>' and '&' in values
+	// that encoding/json applies by default. Keys are still escaped, and the
+	// U+2028/U+2029 line separators are escaped either way.
+	DisableHTMLEscape bool
+
+	// UnquotedNumericKeys emits integer keys bare, e.g. {1: "bar"}, instead
+	// of the standard, valid-JSON {"1": "bar"}. Useful when the output
+	// targets a JSON5 / relaxed parser rather than a strict JSON one. It has
+	// no effect on keys with a custom KeyEncoder, a MarshalJSON, or a
+	// MarshalText method, which are always honored as-is.
+	UnquotedNumericKeys bool
+
+	// TrailingNewline appends a trailing "\n" to the output.
+	TrailingNewline bool
+}
+
+func (opts MarshalJSONOptions) pretty() bool {
+	return opts.Indent != ""
+}
+
+func (opts MarshalJSONOptions) newline(depth int) string {
+	if !opts.pretty() {
+		return ""
+	}
+	return "\n" + opts.Prefix + strings.Repeat(opts.Indent, depth)
+}
+
+func (opts MarshalJSONOptions) colonSep() string {
+	if opts.pretty() {
+		return ": "
+	}
+	return ":"
+}
+
+// MarshalJSONIndent is a convenience around MarshalJSONWithOptions for the
+// common case of just wanting indentation, mirroring json.MarshalIndent: one
+// key/value pair per line, in insertion order.
+func (om *OrderedMap[K, V]) MarshalJSONIndent(prefix, indent string) ([]byte, error) {
+	return om.MarshalJSONWithOptions(MarshalJSONOptions{Prefix: prefix, Indent: indent})
+}
+
+// MarshalJSONWithOptions marshals the map to JSON according to opts.
+// Indentation propagates into nested values reachable through *OrderedMap,
+// []any and map[string]any (in any combination), so the whole document
+// stays readable; any other value, including a nested value of a
+// differently-shaped concrete type, is marshaled as a single compact unit.
+func (om *OrderedMap[K, V]) MarshalJSONWithOptions(opts MarshalJSONOptions) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := om.writeJSONIndent(&buf, opts, 0); err != nil {
+		return nil, err
+	}
+	if opts.TrailingNewline {
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// jsonIndentWriter lets writeJSONIndent recurse into a nested OrderedMap
+// value even though it's a different [K, V] instantiation than the outer map.
+type jsonIndentWriter interface {
+	writeJSONIndent(buf *bytes.Buffer, opts MarshalJSONOptions, depth int) error
+}
+
+func (om *OrderedMap[K, V]) writeJSONIndent(buf *bytes.Buffer, opts MarshalJSONOptions, depth int) error {
+	if om.Len() == 0 {
+		buf.WriteString("{}")
+		return nil
+	}
+
+	buf.WriteByte('{')
+	childIndent := opts.newline(depth + 1)
+
+	for i, key := range om.Keys() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(childIndent)
+
+		keyBytes, err := om.marshalJSONKeyIndent(key, opts)
+		if err != nil {
+			return fmt.Errorf("marshaling key %v: %w", key, err)
+		}
+		buf.Write(keyBytes)
+		buf.WriteString(opts.colonSep())
+
+		value, _ := om.Get(key)
+		if err := writeJSONIndentValue(buf, value, opts, depth+1); err != nil {
+			return fmt.Errorf("marshaling value for key %v: %w", key, err)
+		}
+	}
+
+	buf.WriteString(opts.newline(depth))
+	buf.WriteByte('}')
+	return nil
+}
+
+// marshalJSONKeyIndent renders key the same way marshalJSONKey does, except it
+// also honors opts.UnquotedNumericKeys for keys with no custom encoding.
+func (om *OrderedMap[K, V]) marshalJSONKeyIndent(key K, opts MarshalJSONOptions) ([]byte, error) {
+	if opts.UnquotedNumericKeys && om.keyEncoder == nil {
+		if _, ok := any(key).(json.Marshaler); !ok {
+			if _, ok := any(key).(encoding.TextMarshaler); !ok {
+				if literal, ok := numericKeyLiteral(key); ok {
+					return []byte(literal), nil
+				}
+			}
+		}
+	}
+
+	return om.marshalJSONKey(key)
+}
+
+// writeJSONIndentValue writes value to buf, recursing with indentation into
+// nested OrderedMap, []any and map[string]any values, and falling back to a
+// single json.Marshal-style encoding (honoring opts.DisableHTMLEscape) for
+// everything else.
+func writeJSONIndentValue(buf *bytes.Buffer, value any, opts MarshalJSONOptions, depth int) error {
+	if nested, ok := value.(jsonIndentWriter); ok {
+		return nested.writeJSONIndent(buf, opts, depth)
+	}
+
+	switch v := value.(type) {
+	case []any:
+		return writeJSONIndentArray(buf, v, opts, depth)
+	case map[string]any:
+		return writeJSONIndentObject(buf, v, opts, depth)
+	}
+
+	var valueBuf bytes.Buffer
+	enc := json.NewEncoder(&valueBuf)
+	enc.SetEscapeHTML(!opts.DisableHTMLEscape)
+	if err := enc.Encode(value); err != nil {
+		return err
+	}
+
+	buf.Write(bytes.TrimRight(valueBuf.Bytes(), "\n"))
+	return nil
+}
+
+// writeJSONIndentArray writes arr as an indented JSON array, recursing into
+// each element via writeJSONIndentValue.
+func writeJSONIndentArray(buf *bytes.Buffer, arr []any, opts MarshalJSONOptions, depth int) error {
+	if len(arr) == 0 {
+		buf.WriteString("[]")
+		return nil
+	}
+
+	buf.WriteByte('[')
+	childIndent := opts.newline(depth + 1)
+
+	for i, elem := range arr {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(childIndent)
+
+		if err := writeJSONIndentValue(buf, elem, opts, depth+1); err != nil {
+			return fmt.Errorf("marshaling index %d: %w", i, err)
+		}
+	}
+
+	buf.WriteString(opts.newline(depth))
+	buf.WriteByte(']')
+	return nil
+}
+
+// writeJSONIndentObject writes m as an indented JSON object, keys sorted for
+// determinism (the same rule encoding/json applies to map[string]any), and
+// recursing into each value via writeJSONIndentValue.
+func writeJSONIndentObject(buf *bytes.Buffer, m map[string]any, opts MarshalJSONOptions, depth int) error {
+	if len(m) == 0 {
+		buf.WriteString("{}")
+		return nil
+	}
+
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	buf.WriteByte('{')
+	childIndent := opts.newline(depth + 1)
+
+	for i, key := range keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(childIndent)
+
+		keyBytes, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		buf.Write(keyBytes)
+		buf.WriteString(opts.colonSep())
+
+		if err := writeJSONIndentValue(buf, m[key], opts, depth+1); err != nil {
+			return fmt.Errorf("marshaling key %q: %w", key, err)
+		}
+	}
+
+	buf.WriteString(opts.newline(depth))
+	buf.WriteByte('}')
+	return nil
+}
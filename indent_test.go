@@ -0,0 +1,111 @@
+package orderedmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalJSONIndent(t *testing.T) {
+	t.Run("one pair per line", func(t *testing.T) {
+		om := New[string, any]()
+		om.Set("test", "bar")
+		om.Set("abc", true)
+
+		b, err := om.MarshalJSONIndent("", "  ")
+		assert.NoError(t, err)
+		assert.Equal(t, "{\n  \"test\": \"bar\",\n  \"abc\": true\n}", string(b))
+	})
+
+	t.Run("nested OrderedMap is indented to match", func(t *testing.T) {
+		outer := New[string, any]()
+		inner := New[string, any]()
+		inner.Set("y", 1)
+		outer.Set("x", inner)
+
+		b, err := outer.MarshalJSONIndent("", "  ")
+		assert.NoError(t, err)
+		assert.Equal(t, "{\n  \"x\": {\n    \"y\": 1\n  }\n}", string(b))
+	})
+
+	t.Run("nested OrderedMap reached through a slice is indented to match", func(t *testing.T) {
+		outer := New[string, any]()
+		inner := New[string, any]()
+		inner.Set("y", 1)
+		outer.Set("arr", []any{inner, "z"})
+
+		b, err := outer.MarshalJSONIndent("", "  ")
+		assert.NoError(t, err)
+		assert.Equal(t, "{\n  \"arr\": [\n    {\n      \"y\": 1\n    },\n    \"z\"\n  ]\n}", string(b))
+	})
+
+	t.Run("nested OrderedMap reached through a map[string]any is indented to match", func(t *testing.T) {
+		outer := New[string, any]()
+		inner := New[string, any]()
+		inner.Set("y", 1)
+		outer.Set("obj", map[string]any{"b": inner, "a": 1})
+
+		b, err := outer.MarshalJSONIndent("", "  ")
+		assert.NoError(t, err)
+		assert.Equal(t, "{\n  \"obj\": {\n    \"a\": 1,\n    \"b\": {\n      \"y\": 1\n    }\n  }\n}", string(b))
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		om := New[string, any]()
+
+		b, err := om.MarshalJSONIndent("", "  ")
+		assert.NoError(t, err)
+		assert.Equal(t, "{}", string(b))
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		om := New[string, any]()
+		om.Set("a", 1)
+
+		b, err := om.MarshalJSONIndent(">", "  ")
+		assert.NoError(t, err)
+		assert.Equal(t, "{\n>  \"a\": 1\n>}", string(b))
+	})
+}
+
+func TestMarshalJSONWithOptions(t *testing.T) {
+	t.Run("unquoted numeric keys", func(t *testing.T) {
+		om := New[int, any]()
+		om.Set(1, "bar")
+
+		b, err := om.MarshalJSONWithOptions(MarshalJSONOptions{UnquotedNumericKeys: true})
+		assert.NoError(t, err)
+		assert.Equal(t, `{1:"bar"}`, string(b))
+	})
+
+	t.Run("TextMarshaler keys are unaffected by UnquotedNumericKeys", func(t *testing.T) {
+		om := New[marshallable, any]()
+		om.Set(marshallable(1), "bar")
+
+		b, err := om.MarshalJSONWithOptions(MarshalJSONOptions{UnquotedNumericKeys: true})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"#1#":"bar"}`, string(b))
+	})
+
+	t.Run("disable HTML escape", func(t *testing.T) {
+		om := New[string, any]()
+		om.Set("a", "<b>")
+
+		escaped, err := om.MarshalJSONWithOptions(MarshalJSONOptions{})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a":"\u003cb\u003e"}`, string(escaped))
+
+		unescaped, err := om.MarshalJSONWithOptions(MarshalJSONOptions{DisableHTMLEscape: true})
+		assert.NoError(t, err)
+		assert.Equal(t, `{"a":"<b>"}`, string(unescaped))
+	})
+
+	t.Run("trailing newline", func(t *testing.T) {
+		om := New[string, any]()
+		om.Set("a", 1)
+
+		b, err := om.MarshalJSONWithOptions(MarshalJSONOptions{TrailingNewline: true})
+		assert.NoError(t, err)
+		assert.Equal(t, "{\"a\":1}\n", string(b))
+	})
+}
@@ -0,0 +1,101 @@
+package orderedmap
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// MarshalJSON implements the json.Marshaler interface, emitting the map's
+// entries as a JSON object in insertion order.
+func (om *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	for i, key := range om.Keys() {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		keyBytes, err := om.marshalJSONKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling key %v: %w", key, err)
+		}
+		buf.Write(keyBytes)
+		buf.WriteByte(':')
+
+		value, _ := om.Get(key)
+		valueBytes, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling value for key %v: %w", key, err)
+		}
+		buf.Write(valueBytes)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// marshalJSONKey renders key as a JSON string. A custom KeyEncoder (set via
+// WithKeyCodec) takes precedence; otherwise, a key implementing
+// json.Marshaler is honored directly, falling back to the TextMarshaler and
+// string/int families handled by keyToString.
+func (om *OrderedMap[K, V]) marshalJSONKey(key K) ([]byte, error) {
+	if om.keyEncoder == nil {
+		if jm, ok := any(key).(json.Marshaler); ok {
+			return jm.MarshalJSON()
+		}
+	}
+
+	keyStr, err := om.encodeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(keyStr)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It rejects JSON
+// documents whose root isn't an object.
+func (om *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	if om.nodes == nil {
+		om.nodes = make(map[K]*entry[K, V])
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("orderedmap: expected a JSON object, got %v", token)
+	}
+
+	for dec.More() {
+		keyToken, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		keyStr, ok := keyToken.(string)
+		if !ok {
+			return fmt.Errorf("orderedmap: expected a string object key, got %v", keyToken)
+		}
+
+		key, err := om.decodeKey(keyStr)
+		if err != nil {
+			return fmt.Errorf("unmarshaling key %q: %w", keyStr, err)
+		}
+
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return fmt.Errorf("unmarshaling value for key %q: %w", keyStr, err)
+		}
+
+		om.Set(key, value)
+	}
+
+	// consume the closing '}'
+	_, err = dec.Token()
+	return err
+}
@@ -0,0 +1,89 @@
+package orderedmap
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// keyToString renders a map key as a string, the representation both JSON
+// object keys and YAML mapping keys need. TextMarshaler is honored first,
+// then the built-in string/int families, falling back to fmt.Sprintf for
+// anything else.
+func keyToString(key any) (string, error) {
+	if tm, ok := key.(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		if err != nil {
+			return "", err
+		}
+		return string(text), nil
+	}
+
+	if s, ok := key.(string); ok {
+		return s, nil
+	}
+
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	default:
+		return fmt.Sprintf("%v", key), nil
+	}
+}
+
+// numericKeyLiteral returns key's bare numeric JSON literal (no quotes) if
+// key is one of the built-in int/uint families, for callers that want to
+// emit keys unquoted.
+func numericKeyLiteral(key any) (string, bool) {
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), true
+	default:
+		return "", false
+	}
+}
+
+// stringToKey parses a string back into a key of type K, mirroring the
+// encoding rules in keyToString.
+func stringToKey[K comparable](s string) (K, error) {
+	var zero K
+
+	if tu, ok := any(&zero).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(s))
+		return zero, err
+	}
+
+	if _, ok := any(zero).(string); ok {
+		return any(s).(K), nil
+	}
+
+	v := reflect.ValueOf(&zero).Elem()
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return zero, err
+		}
+		v.SetInt(n)
+		return zero, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return zero, err
+		}
+		v.SetUint(n)
+		return zero, nil
+	case reflect.String:
+		v.SetString(s)
+		return zero, nil
+	default:
+		return zero, fmt.Errorf("unsupported key type %T", zero)
+	}
+}
@@ -0,0 +1,77 @@
+package orderedmap
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// jsonMarshalledKey implements json.Marshaler directly, without going
+// through encoding.TextMarshaler.
+type jsonMarshalledKey int
+
+func (k jsonMarshalledKey) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"key-%d"`, k)), nil
+}
+
+func TestMarshalJSONKeyMarshaler(t *testing.T) {
+	om := New[jsonMarshalledKey, any]()
+	om.Set(jsonMarshalledKey(1), "bar")
+	om.Set(jsonMarshalledKey(2), "baz")
+
+	b, err := json.Marshal(om)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"key-1":"bar","key-2":"baz"}`, string(b))
+}
+
+// label is a named string type, with no MarshalText/MarshalJSON of its own,
+// used to check that the default key codec round-trips named string types
+// the same way it already does named int types.
+type label string
+
+func TestNamedStringKeyRoundTrip(t *testing.T) {
+	om := New[label, any]()
+	om.Set(label("x"), "bar")
+
+	b, err := json.Marshal(om)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"x":"bar"}`, string(b))
+
+	decoded := New[label, any]()
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assertOrderedPairsEqual(t, decoded, []label{"x"}, []any{"bar"})
+}
+
+// uuidKey stands in for a third-party key type that owns neither
+// MarshalText nor MarshalJSON.
+type uuidKey struct {
+	hi, lo uint64
+}
+
+func TestWithKeyCodec(t *testing.T) {
+	encoder := func(k uuidKey) (string, error) {
+		return fmt.Sprintf("%x-%x", k.hi, k.lo), nil
+	}
+	decoder := func(s string) (uuidKey, error) {
+		var hi, lo uint64
+		if _, err := fmt.Sscanf(s, "%x-%x", &hi, &lo); err != nil {
+			return uuidKey{}, err
+		}
+		return uuidKey{hi: hi, lo: lo}, nil
+	}
+
+	om := NewWithOptions[uuidKey, any](WithKeyCodec[uuidKey, any](encoder, decoder))
+	key := uuidKey{hi: 1, lo: 28}
+	om.Set(key, "bar")
+
+	b, err := json.Marshal(om)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"1-1c":"bar"}`, string(b))
+
+	decoded := NewWithOptions[uuidKey, any](WithKeyCodec[uuidKey, any](encoder, decoder))
+	require.NoError(t, json.Unmarshal(b, &decoded))
+	assertOrderedPairsEqual(t, decoded, []uuidKey{key}, []any{"bar"})
+}
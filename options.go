@@ -0,0 +1,55 @@
+package orderedmap
+
+// KeyEncoder converts a map key to its string representation, for use by the
+// JSON and YAML marshallers.
+type KeyEncoder[K any] func(key K) (string, error)
+
+// KeyDecoder parses a map key back out of its string representation, for use
+// by the JSON and YAML unmarshallers.
+type KeyDecoder[K any] func(s string) (K, error)
+
+// Option configures an OrderedMap created via NewWithOptions.
+type Option[K comparable, V any] func(*OrderedMap[K, V])
+
+// WithKeyCodec overrides the default key string conversion (TextMarshaler,
+// then the built-in string/int families) with a caller-supplied encoder and
+// decoder pair. This is the way to use key types that don't implement
+// encoding.TextMarshaler/TextUnmarshaler, such as third-party UUID types
+// without those methods.
+func WithKeyCodec[K comparable, V any](encoder KeyEncoder[K], decoder KeyDecoder[K]) Option[K, V] {
+	return func(om *OrderedMap[K, V]) {
+		om.keyEncoder = encoder
+		om.keyDecoder = decoder
+	}
+}
+
+// NewWithOptions creates a new empty OrderedMap configured by opts.
+func NewWithOptions[K comparable, V any](opts ...Option[K, V]) *OrderedMap[K, V] {
+	om := &OrderedMap[K, V]{
+		nodes: make(map[K]*entry[K, V]),
+	}
+
+	for _, opt := range opts {
+		opt(om)
+	}
+
+	return om
+}
+
+// encodeKey renders key as a string, preferring the instance's custom
+// KeyEncoder if one was configured via WithKeyCodec.
+func (om *OrderedMap[K, V]) encodeKey(key K) (string, error) {
+	if om.keyEncoder != nil {
+		return om.keyEncoder(key)
+	}
+	return keyToString(key)
+}
+
+// decodeKey parses a string back into a key, preferring the instance's
+// custom KeyDecoder if one was configured via WithKeyCodec.
+func (om *OrderedMap[K, V]) decodeKey(s string) (K, error) {
+	if om.keyDecoder != nil {
+		return om.keyDecoder(s)
+	}
+	return stringToKey[K](s)
+}
@@ -0,0 +1,140 @@
+// Package orderedmap provides a map that preserves the insertion order of its
+// keys, similarly to what an OrderedDict does in Python.
+package orderedmap
+
+// entry is one node of the doubly-linked list that backs an OrderedMap. It
+// exists so that Delete, MoveBefore, MoveToFront and MoveToBack are O(1)
+// instead of requiring a scan and a slice rewrite. InsertAt at a middle
+// position still walks the list to reach that position.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+
+	prev, next *entry[K, V]
+}
+
+// OrderedMap is a map that remembers the order in which keys were inserted,
+// much like Python's collections.OrderedDict. Keys re-inserted via Set keep
+// their original position; only new keys are appended. Entries are stored in
+// a map keyed by K plus a doubly-linked list tracking iteration order.
+//
+// The zero value is not usable; create one with New or NewWithOptions.
+type OrderedMap[K comparable, V any] struct {
+	nodes       map[K]*entry[K, V]
+	front, back *entry[K, V]
+
+	keyEncoder KeyEncoder[K]
+	keyDecoder KeyDecoder[K]
+}
+
+// New creates a new empty OrderedMap.
+func New[K comparable, V any]() *OrderedMap[K, V] {
+	return NewWithOptions[K, V]()
+}
+
+// Set inserts or updates a key/value pair. If the key is new, it's appended
+// to the end of the iteration order; if it already exists, its position is
+// left unchanged and only the value is updated.
+func (om *OrderedMap[K, V]) Set(key K, value V) {
+	if e, present := om.nodes[key]; present {
+		e.value = value
+		return
+	}
+
+	e := &entry[K, V]{key: key, value: value}
+	om.nodes[key] = e
+	om.pushBack(e)
+}
+
+// Get looks up a key, returning its value and whether it was present.
+func (om *OrderedMap[K, V]) Get(key K) (value V, present bool) {
+	e, present := om.nodes[key]
+	if !present {
+		return value, false
+	}
+	return e.value, true
+}
+
+// Delete removes a key from the map, if present.
+func (om *OrderedMap[K, V]) Delete(key K) {
+	e, present := om.nodes[key]
+	if !present {
+		return
+	}
+	om.unlink(e)
+	delete(om.nodes, key)
+}
+
+// Len returns the number of entries in the map.
+func (om *OrderedMap[K, V]) Len() int {
+	return len(om.nodes)
+}
+
+// Keys returns the map's keys in insertion order.
+func (om *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, len(om.nodes))
+	for e := om.front; e != nil; e = e.next {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+// pushBack appends e, which must not already be linked, to the end of the
+// list.
+func (om *OrderedMap[K, V]) pushBack(e *entry[K, V]) {
+	e.prev, e.next = om.back, nil
+	if om.back != nil {
+		om.back.next = e
+	} else {
+		om.front = e
+	}
+	om.back = e
+}
+
+// pushFront prepends e, which must not already be linked, to the start of
+// the list.
+func (om *OrderedMap[K, V]) pushFront(e *entry[K, V]) {
+	e.prev, e.next = nil, om.front
+	if om.front != nil {
+		om.front.prev = e
+	} else {
+		om.back = e
+	}
+	om.front = e
+}
+
+// insertBefore links e, which must not already be linked, immediately before
+// mark.
+func (om *OrderedMap[K, V]) insertBefore(e, mark *entry[K, V]) {
+	e.prev, e.next = mark.prev, mark
+	if mark.prev != nil {
+		mark.prev.next = e
+	} else {
+		om.front = e
+	}
+	mark.prev = e
+}
+
+// unlink removes e from the list without touching the nodes map.
+func (om *OrderedMap[K, V]) unlink(e *entry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		om.front = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		om.back = e.prev
+	}
+	e.prev, e.next = nil, nil
+}
+
+// nodeAt returns the entry at the given 0-based position, assumed in range.
+func (om *OrderedMap[K, V]) nodeAt(index int) *entry[K, V] {
+	e := om.front
+	for ; index > 0; index-- {
+		e = e.next
+	}
+	return e
+}
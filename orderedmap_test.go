@@ -0,0 +1,34 @@
+package orderedmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func assertOrderedPairsEqual[K comparable, V any](t *testing.T, om *OrderedMap[K, V], expectedKeys []K, expectedValues []V) {
+	t.Helper()
+	require.Equal(t, len(expectedKeys), len(expectedValues), "expectedKeys and expectedValues must have the same length")
+	assertLenEqual(t, om, len(expectedKeys))
+
+	for i, key := range expectedKeys {
+		value, present := om.Get(key)
+		assert.Truef(t, present, "key %v should be present", key)
+		assert.Equal(t, expectedValues[i], value)
+	}
+
+	assert.Equal(t, expectedKeys, om.Keys())
+}
+
+func assertLenEqual[K comparable, V any](t *testing.T, om *OrderedMap[K, V], expectedLen int) {
+	t.Helper()
+	assert.Equal(t, expectedLen, om.Len())
+}
+
+func mustGet[K comparable, V any](t *testing.T, om *OrderedMap[K, V], key K) V {
+	t.Helper()
+	value, present := om.Get(key)
+	require.Truef(t, present, "key %v should be present", key)
+	return value
+}
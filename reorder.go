@@ -0,0 +1,107 @@
+package orderedmap
+
+import (
+	"fmt"
+	"sort"
+)
+
+// InsertAt inserts a new key/value pair at the given 0-based position,
+// shifting everything from that position onward one slot later. A negative
+// index counts from the end, with -1 meaning the last position (i.e. the
+// new entry becomes the last one). It returns an error if the key already
+// exists or the index is out of range.
+func (om *OrderedMap[K, V]) InsertAt(index int, key K, value V) error {
+	if _, exists := om.nodes[key]; exists {
+		return fmt.Errorf("orderedmap: key %v already exists", key)
+	}
+
+	n := om.Len()
+	if index < 0 {
+		index = n + 1 + index
+	}
+	if index < 0 || index > n {
+		return fmt.Errorf("orderedmap: index out of range")
+	}
+
+	e := &entry[K, V]{key: key, value: value}
+	om.nodes[key] = e
+
+	switch {
+	case n == 0:
+		om.front, om.back = e, e
+	case index == n:
+		om.pushBack(e)
+	case index == 0:
+		om.pushFront(e)
+	default:
+		om.insertBefore(e, om.nodeAt(index))
+	}
+
+	return nil
+}
+
+// MoveBefore moves key so that it immediately precedes mark in iteration
+// order. It returns an error if either key is absent from the map.
+func (om *OrderedMap[K, V]) MoveBefore(key, mark K) error {
+	e, ok := om.nodes[key]
+	if !ok {
+		return fmt.Errorf("orderedmap: key %v not found", key)
+	}
+	m, ok := om.nodes[mark]
+	if !ok {
+		return fmt.Errorf("orderedmap: mark key %v not found", mark)
+	}
+	if e == m {
+		return nil
+	}
+
+	om.unlink(e)
+	om.insertBefore(e, m)
+	return nil
+}
+
+// MoveToFront moves key to the front of the iteration order. It's a no-op if
+// key isn't present.
+func (om *OrderedMap[K, V]) MoveToFront(key K) {
+	e, ok := om.nodes[key]
+	if !ok || om.front == e {
+		return
+	}
+	om.unlink(e)
+	om.pushFront(e)
+}
+
+// MoveToBack moves key to the back of the iteration order. It's a no-op if
+// key isn't present.
+func (om *OrderedMap[K, V]) MoveToBack(key K) {
+	e, ok := om.nodes[key]
+	if !ok || om.back == e {
+		return
+	}
+	om.unlink(e)
+	om.pushBack(e)
+}
+
+// SortKeys reorders the map's entries in place according to less, without
+// rebuilding the map itself.
+func (om *OrderedMap[K, V]) SortKeys(less func(a, b K) bool) {
+	keys := om.Keys()
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+
+	var prev *entry[K, V]
+	om.front = nil
+	for _, key := range keys {
+		e := om.nodes[key]
+		e.prev = prev
+		if prev != nil {
+			prev.next = e
+		} else {
+			om.front = e
+		}
+		prev = e
+	}
+	if prev != nil {
+		prev.next = nil
+	}
+	om.back = prev
+}
@@ -0,0 +1,112 @@
+package orderedmap
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLetters() *OrderedMap[string, int] {
+	om := New[string, int]()
+	om.Set("a", 1)
+	om.Set("b", 2)
+	om.Set("c", 3)
+	return om
+}
+
+func TestInsertAt(t *testing.T) {
+	t.Run("middle", func(t *testing.T) {
+		om := newLetters()
+		require.NoError(t, om.InsertAt(1, "x", 9))
+		assertOrderedPairsEqual(t, om, []string{"a", "x", "b", "c"}, []int{1, 9, 2, 3})
+	})
+
+	t.Run("front", func(t *testing.T) {
+		om := newLetters()
+		require.NoError(t, om.InsertAt(0, "x", 9))
+		assertOrderedPairsEqual(t, om, []string{"x", "a", "b", "c"}, []int{9, 1, 2, 3})
+	})
+
+	t.Run("back", func(t *testing.T) {
+		om := newLetters()
+		require.NoError(t, om.InsertAt(3, "x", 9))
+		assertOrderedPairsEqual(t, om, []string{"a", "b", "c", "x"}, []int{1, 2, 3, 9})
+	})
+
+	t.Run("negative index counts from the end", func(t *testing.T) {
+		om := newLetters()
+		require.NoError(t, om.InsertAt(-1, "x", 9))
+		assertOrderedPairsEqual(t, om, []string{"a", "b", "c", "x"}, []int{1, 2, 3, 9})
+	})
+
+	t.Run("into an empty map", func(t *testing.T) {
+		om := New[string, int]()
+		require.NoError(t, om.InsertAt(0, "a", 1))
+		assertOrderedPairsEqual(t, om, []string{"a"}, []int{1})
+	})
+
+	t.Run("existing key is rejected", func(t *testing.T) {
+		om := newLetters()
+		assert.Error(t, om.InsertAt(0, "a", 9))
+	})
+
+	t.Run("out of range index is rejected", func(t *testing.T) {
+		om := newLetters()
+		assert.Error(t, om.InsertAt(4, "x", 9))
+		assert.Error(t, om.InsertAt(-5, "x", 9))
+	})
+}
+
+func TestMoveBefore(t *testing.T) {
+	om := newLetters()
+	require.NoError(t, om.MoveBefore("c", "a"))
+	assertOrderedPairsEqual(t, om, []string{"c", "a", "b"}, []int{3, 1, 2})
+
+	require.Error(t, om.MoveBefore("missing", "a"))
+	require.Error(t, om.MoveBefore("a", "missing"))
+}
+
+func TestMoveToFront(t *testing.T) {
+	om := newLetters()
+	om.MoveToFront("c")
+	assertOrderedPairsEqual(t, om, []string{"c", "a", "b"}, []int{3, 1, 2})
+
+	// no-op when already at the front, or when the key is missing
+	om.MoveToFront("c")
+	assertOrderedPairsEqual(t, om, []string{"c", "a", "b"}, []int{3, 1, 2})
+	om.MoveToFront("missing")
+	assertOrderedPairsEqual(t, om, []string{"c", "a", "b"}, []int{3, 1, 2})
+}
+
+func TestMoveToBack(t *testing.T) {
+	om := newLetters()
+	om.MoveToBack("a")
+	assertOrderedPairsEqual(t, om, []string{"b", "c", "a"}, []int{2, 3, 1})
+
+	om.MoveToBack("a")
+	assertOrderedPairsEqual(t, om, []string{"b", "c", "a"}, []int{2, 3, 1})
+	om.MoveToBack("missing")
+	assertOrderedPairsEqual(t, om, []string{"b", "c", "a"}, []int{2, 3, 1})
+}
+
+func TestSortKeys(t *testing.T) {
+	om := New[int, string]()
+	om.Set(3, "c")
+	om.Set(1, "a")
+	om.Set(2, "b")
+
+	om.SortKeys(func(a, b int) bool { return a < b })
+
+	assertOrderedPairsEqual(t, om, []int{1, 2, 3}, []string{"a", "b", "c"})
+}
+
+func TestReorderingUpdatesJSONOutput(t *testing.T) {
+	om := newLetters()
+	om.MoveToFront("c")
+
+	b, err := json.Marshal(om)
+	require.NoError(t, err)
+	assert.Equal(t, `{"c":3,"a":1,"b":2}`, string(b))
+}
@@ -0,0 +1,65 @@
+package orderedmap
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MarshalYAML implements the yaml.Marshaler interface (gopkg.in/yaml.v3),
+// emitting the map's entries as a YAML mapping node in insertion order.
+func (om *OrderedMap[K, V]) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+
+	for _, key := range om.Keys() {
+		keyStr, err := om.encodeKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("marshaling key %v: %w", key, err)
+		}
+
+		keyNode := &yaml.Node{}
+		if err := keyNode.Encode(keyStr); err != nil {
+			return nil, fmt.Errorf("marshaling key %v: %w", key, err)
+		}
+
+		value, _ := om.Get(key)
+		valueNode := &yaml.Node{}
+		if err := valueNode.Encode(value); err != nil {
+			return nil, fmt.Errorf("marshaling value for key %v: %w", key, err)
+		}
+
+		node.Content = append(node.Content, keyNode, valueNode)
+	}
+
+	return node, nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface. It rejects YAML
+// documents whose root isn't a mapping.
+func (om *OrderedMap[K, V]) UnmarshalYAML(value *yaml.Node) error {
+	if om.nodes == nil {
+		om.nodes = make(map[K]*entry[K, V])
+	}
+
+	if value.Kind != yaml.MappingNode {
+		return fmt.Errorf("orderedmap: expected a YAML mapping, got kind %v", value.Kind)
+	}
+
+	for i := 0; i < len(value.Content); i += 2 {
+		keyNode, valueNode := value.Content[i], value.Content[i+1]
+
+		key, err := om.decodeKey(keyNode.Value)
+		if err != nil {
+			return fmt.Errorf("unmarshaling key %q: %w", keyNode.Value, err)
+		}
+
+		var v V
+		if err := valueNode.Decode(&v); err != nil {
+			return fmt.Errorf("unmarshaling value for key %q: %w", keyNode.Value, err)
+		}
+
+		om.Set(key, v)
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package orderedmap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestMarshalYAML(t *testing.T) {
+	t.Run("int key", func(t *testing.T) {
+		om := New[int, any]()
+		om.Set(1, "bar")
+		om.Set(7, "baz")
+		om.Set(2, 28)
+
+		b, err := yaml.Marshal(om)
+		assert.NoError(t, err)
+		assert.Equal(t, "\"1\": bar\n\"7\": baz\n\"2\": 28\n", string(b))
+	})
+
+	t.Run("string key", func(t *testing.T) {
+		om := New[string, any]()
+		om.Set("test", "bar")
+		om.Set("abc", true)
+
+		b, err := yaml.Marshal(om)
+		assert.NoError(t, err)
+		assert.Equal(t, "test: bar\nabc: true\n", string(b))
+	})
+
+	t.Run("TextMarshaller key", func(t *testing.T) {
+		om := New[marshallable, any]()
+		om.Set(marshallable(1), "bar")
+		om.Set(marshallable(28), true)
+
+		b, err := yaml.Marshal(om)
+		assert.NoError(t, err)
+		// yaml.v3 only forces double quotes on scalars that would otherwise
+		// resolve to a non-string type (e.g. "1"); a plain-unsafe string like
+		// "#1#" (leading '#' reads as a comment marker) gets single quotes.
+		assert.Equal(t, "'#1#': bar\n'#28#': true\n", string(b))
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		om := New[string, any]()
+
+		b, err := yaml.Marshal(om)
+		assert.NoError(t, err)
+		assert.Equal(t, "{}\n", string(b))
+	})
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	t.Run("int key", func(t *testing.T) {
+		data := "1: bar\n7: baz\n2: 28\n"
+
+		om := New[int, any]()
+		require.NoError(t, yaml.Unmarshal([]byte(data), &om))
+
+		assertOrderedPairsEqual(t, om,
+			[]int{1, 7, 2},
+			[]any{"bar", "baz", 28})
+	})
+
+	t.Run("string key", func(t *testing.T) {
+		data := "test: bar\nabc: true\n"
+
+		om := New[string, any]()
+		require.NoError(t, yaml.Unmarshal([]byte(data), &om))
+
+		assertOrderedPairsEqual(t, om,
+			[]string{"test", "abc"},
+			[]any{"bar", true})
+	})
+
+	t.Run("TextUnmarshaler key", func(t *testing.T) {
+		data := "\"#1#\": bar\n\"#28#\": true\n"
+
+		om := New[marshallable, any]()
+		require.NoError(t, yaml.Unmarshal([]byte(data), &om))
+
+		assertOrderedPairsEqual(t, om,
+			[]marshallable{1, 28},
+			[]any{"bar", true})
+	})
+
+	t.Run("when fed with an input that's not a mapping", func(t *testing.T) {
+		for _, data := range []string{"true", "[foo]", "42", "foo"} {
+			om := New[int, any]()
+			require.Error(t, yaml.Unmarshal([]byte(data), &om))
+		}
+	})
+
+	t.Run("empty map", func(t *testing.T) {
+		data := "{}\n"
+
+		om := New[int, any]()
+		require.NoError(t, yaml.Unmarshal([]byte(data), &om))
+
+		assertLenEqual(t, om, 0)
+	})
+}